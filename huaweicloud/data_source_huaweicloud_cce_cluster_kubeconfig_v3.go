@@ -0,0 +1,68 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/cce/v3/clusters"
+)
+
+func dataSourceCCEClusterKubeConfigV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCCEClusterKubeConfigV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceCCEClusterKubeConfigV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	certOpts := clusters.GetCertOpts{
+		Duration: d.Get("duration_days").(int),
+	}
+	cert, err := clusters.GetCertWithOpts(cceClient, clusterId, certOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error fetching HuaweiCloud CCE cluster cert: %s", err)
+	}
+
+	kubeConfigRaw, err := buildKubeConfigRaw(cert, d.Get("user").(string))
+	if err != nil {
+		return fmt.Errorf("Error building kube_config_raw for HuaweiCloud CCE cluster %s: %s", clusterId, err)
+	}
+	d.Set("kube_config_raw", kubeConfigRaw)
+	d.Set("region", GetRegion(d, config))
+
+	d.SetId(clusterId)
+
+	return nil
+}
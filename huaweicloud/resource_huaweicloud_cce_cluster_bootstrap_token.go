@@ -0,0 +1,351 @@
+package huaweicloud
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/cce/v3/clusters"
+)
+
+const bootstrapTokenCharset = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func resourceCCEClusterBootstrapTokenV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCCEClusterBootstrapTokenV3Create,
+		Read:   resourceCCEClusterBootstrapTokenV3Read,
+		Delete: resourceCCEClusterBootstrapTokenV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "24h0m0s",
+				ValidateFunc: validateTTL,
+			},
+			"usages": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"bootstrap_ca_cert_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"join_command": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func generateBootstrapToken() (string, string, error) {
+	id, err := randomBootstrapTokenString(6)
+	if err != nil {
+		return "", "", err
+	}
+	secret, err := randomBootstrapTokenString(16)
+	if err != nil {
+		return "", "", err
+	}
+	return id, secret, nil
+}
+
+func randomBootstrapTokenString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("Error generating random bootstrap token: %s", err)
+	}
+
+	b := make([]byte, n)
+	for i, v := range buf {
+		b[i] = bootstrapTokenCharset[int(v)%len(bootstrapTokenCharset)]
+	}
+	return string(b), nil
+}
+
+func resourceCCEClusterBootstrapTokenV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Unable to create HuaweiCloud CCE client : %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	cert, err := clusters.GetCert(cceClient, clusterId).Extract()
+	if err != nil {
+		return fmt.Errorf("Error fetching HuaweiCloud CCE cluster cert: %s", err)
+	}
+
+	tokenID, tokenSecret, err := generateBootstrapToken()
+	if err != nil {
+		return err
+	}
+	token := fmt.Sprintf("%s.%s", tokenID, tokenSecret)
+
+	usages := expandStringList(d.Get("usages").([]interface{}))
+	if len(usages) == 0 {
+		usages = []string{"signing", "authentication"}
+	}
+	groups := expandStringList(d.Get("groups").([]interface{}))
+
+	if err := postBootstrapTokenSecret(cert, tokenID, tokenSecret, d.Get("ttl").(string), usages, groups, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE bootstrap token: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterId, tokenID))
+	d.Set("token", token)
+
+	if len(cert.Clusters) > 0 {
+		server := cert.Clusters[0].Cluster.Server
+		caHash, err := computeCACertHash(cert.Clusters[0].Cluster.CertAuthorityData)
+		if err != nil {
+			return fmt.Errorf("Error computing bootstrap_ca_cert_hash for HuaweiCloud CCE cluster %s: %s", clusterId, err)
+		}
+		d.Set("bootstrap_ca_cert_hash", caHash)
+		d.Set("join_command", fmt.Sprintf(
+			"kubeadm join %s --token %s --discovery-token-ca-cert-hash %s",
+			server, token, caHash))
+	}
+
+	return resourceCCEClusterBootstrapTokenV3Read(d, meta)
+}
+
+// computeCACertHash DER-encodes the SubjectPublicKeyInfo of the given
+// base64-encoded PEM CA certificate and sha256's it, matching kubeadm's
+// discovery-token-ca-cert-hash verification.
+func computeCACertHash(caCertDataB64 string) (string, error) {
+	pemData, err := base64.StdEncoding.DecodeString(caCertDataB64)
+	if err != nil {
+		return "", fmt.Errorf("invalid CA certificate data: %s", err)
+	}
+
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return "", fmt.Errorf("could not decode PEM block from CA certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("could not parse CA certificate: %s", err)
+	}
+
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return fmt.Sprintf("sha256:%x", sum), nil
+}
+
+func resourceCCEClusterBootstrapTokenV3Read(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceCCEClusterBootstrapTokenV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Unable to create HuaweiCloud CCE client : %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	cert, err := clusters.GetCert(cceClient, clusterId).Extract()
+	if err != nil {
+		return fmt.Errorf("Error fetching HuaweiCloud CCE cluster cert: %s", err)
+	}
+
+	tokenID := strings.SplitN(d.Get("token").(string), ".", 2)[0]
+	if err := deleteBootstrapTokenSecret(cert, tokenID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CCE bootstrap token: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// postBootstrapTokenSecret authenticates to the cluster's kube-apiserver with
+// the fetched client certificate and creates the bootstrap-token Secret in
+// kube-system, matching the format kubeadm expects for token discovery.
+func postBootstrapTokenSecret(cert *clusters.Certificate, tokenID, tokenSecret, ttl string, usages, groups []string, timeout time.Duration) error {
+	client, server, err := bootstrapTokenHTTPClient(cert, timeout)
+	if err != nil {
+		return err
+	}
+
+	secretName := fmt.Sprintf("bootstrap-token-%s", tokenID)
+	stringData := map[string]string{
+		"token-id":     tokenID,
+		"token-secret": tokenSecret,
+		"expiration":   time.Now().Add(parseTTLOrDefault(ttl)).UTC().Format(time.RFC3339),
+	}
+	for _, usage := range usages {
+		stringData[fmt.Sprintf("usage-bootstrap-%s", usage)] = "true"
+	}
+	if len(groups) > 0 {
+		stringData["auth-extra-groups"] = strings.Join(groups, ",")
+	}
+
+	secret := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"type":       "bootstrap.kubernetes.io/token",
+		"metadata": map[string]interface{}{
+			"name":      secretName,
+			"namespace": "kube-system",
+		},
+		"stringData": stringData,
+	}
+
+	body, err := json.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/api/v1/namespaces/kube-system/secrets", server)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response creating bootstrap-token secret: %s", resp.Status)
+	}
+
+	return nil
+}
+
+func deleteBootstrapTokenSecret(cert *clusters.Certificate, tokenID string, timeout time.Duration) error {
+	client, server, err := bootstrapTokenHTTPClient(cert, timeout)
+	if err != nil {
+		return err
+	}
+
+	secretName := fmt.Sprintf("bootstrap-token-%s", tokenID)
+	url := fmt.Sprintf("%s/api/v1/namespaces/kube-system/secrets/%s", server, secretName)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("unexpected response deleting bootstrap-token secret: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// bootstrapTokenHTTPClient reaches the cluster's kube-apiserver directly, so
+// it needs its own Timeout: a private cluster with authorized_networks
+// restrictions (huaweicloud_cce_cluster_v3's endpoint_access) can leave the
+// host running terraform apply with no route to the server, and the
+// standard CCE waiters don't cover this raw Kubernetes-API request.
+func bootstrapTokenHTTPClient(cert *clusters.Certificate, timeout time.Duration) (*http.Client, string, error) {
+	if len(cert.Clusters) == 0 || len(cert.Users) == 0 {
+		return nil, "", fmt.Errorf("cluster certificate does not contain cluster/user credentials")
+	}
+
+	clusterObj := cert.Clusters[0]
+	userObj := cert.Users[0]
+
+	caData, err := base64.StdEncoding.DecodeString(clusterObj.Cluster.CertAuthorityData)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid CA certificate data: %s", err)
+	}
+	certData, err := base64.StdEncoding.DecodeString(userObj.User.ClientCertData)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid client certificate data: %s", err)
+	}
+	keyData, err := base64.StdEncoding.DecodeString(userObj.User.ClientKeyData)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid client key data: %s", err)
+	}
+
+	clientCert, err := tls.X509KeyPair(certData, keyData)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid client certificate/key pair: %s", err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caData) {
+		return nil, "", fmt.Errorf("could not parse CA certificate")
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				Certificates: []tls.Certificate{clientCert},
+				RootCAs:      caPool,
+			},
+		},
+	}
+
+	return client, clusterObj.Cluster.Server, nil
+}
+
+func parseTTLOrDefault(ttl string) time.Duration {
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 24 * time.Hour
+	}
+	return d
+}
+
+func validateTTL(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid duration: %s", k, err))
+	}
+	return
+}
+
+func expandStringList(raw []interface{}) []string {
+	out := make([]string, len(raw))
+	for i, v := range raw {
+		out[i] = v.(string)
+	}
+	return out
+}
@@ -3,6 +3,8 @@ package huaweicloud
 import (
 	"fmt"
 	log "github.com/sourcegraph-ce/logrus"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -11,6 +13,55 @@ import (
 	"github.com/huaweicloud/golangsdk/openstack/cce/v3/clusters"
 )
 
+const kubeConfigTemplate = `apiVersion: v1
+clusters:
+- cluster:
+    server: %s
+    certificate-authority-data: %s
+  name: %s
+contexts:
+- context:
+    cluster: %s
+    user: %s
+  name: %s
+current-context: %s
+kind: Config
+preferences: {}
+users:
+- name: %s
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+`
+
+// buildKubeConfigRaw assembles a standard kubeconfig YAML document out of the
+// cluster/user certificate bundle returned by the CCE cert API, so it can be
+// fed directly into the kubernetes/helm providers.
+func buildKubeConfigRaw(cert *clusters.Certificate, userName string) (string, error) {
+	if len(cert.Clusters) == 0 || len(cert.Users) == 0 {
+		return "", fmt.Errorf("cluster certificate does not contain cluster/user credentials")
+	}
+
+	clusterObj := cert.Clusters[0]
+	userObj := cert.Users[0]
+	if userName != "" {
+		for _, u := range cert.Users {
+			if u.Name == userName {
+				userObj = u
+				break
+			}
+		}
+	}
+	contextName := fmt.Sprintf("%s@%s", userObj.Name, clusterObj.Name)
+
+	return fmt.Sprintf(kubeConfigTemplate,
+		clusterObj.Cluster.Server, clusterObj.Cluster.CertAuthorityData, clusterObj.Name,
+		clusterObj.Name, userObj.Name, contextName,
+		contextName,
+		userObj.Name, userObj.User.ClientCertData, userObj.User.ClientKeyData,
+	), nil
+}
+
 func resourceCCEClusterV3() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCCEClusterV3Create,
@@ -23,6 +74,7 @@ func resourceCCEClusterV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -127,7 +179,7 @@ func resourceCCEClusterV3() *schema.Resource {
 			"eip": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
+				Computed:     true,
 				ValidateFunc: validateIP,
 			},
 			"kube_proxy_mode": {
@@ -135,6 +187,44 @@ func resourceCCEClusterV3() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"masters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"endpoint_access": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_access_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"private_access_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"authorized_networks": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCIDR},
+						},
+					},
+				},
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -159,6 +249,11 @@ func resourceCCEClusterV3() *schema.Resource {
 					},
 				},
 			},
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"certificate_users": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -211,9 +306,75 @@ func resourceClusterExtendParamV3(d *schema.ResourceData) map[string]string {
 	if eip, ok := d.GetOk("eip"); ok {
 		m["clusterExternalIP"] = eip.(string)
 	}
+	for key, val := range resourceClusterEndpointAccessExtendParamV3(d) {
+		m[key] = val
+	}
 	return m
 }
 
+// resourceClusterEndpointAccessExtendParamV3 maps the endpoint_access block
+// onto the extendParam keys the CCE cluster API expects. It is shared by
+// Create and Update so an endpoint_access change can be pushed to the API
+// instead of silently no-op'ing.
+func resourceClusterEndpointAccessExtendParamV3(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+
+	v, ok := d.GetOk("endpoint_access")
+	if !ok {
+		return m
+	}
+
+	access := v.([]interface{})[0].(map[string]interface{})
+	m["publicAccessEnabled"] = strconv.FormatBool(access["public_access_enabled"].(bool))
+	m["privateAccessEnabled"] = strconv.FormatBool(access["private_access_enabled"].(bool))
+
+	var networks []string
+	for _, n := range access["authorized_networks"].([]interface{}) {
+		networks = append(networks, n.(string))
+	}
+	if len(networks) > 0 {
+		m["authorizedNetworks"] = strings.Join(networks, ",")
+	}
+
+	return m
+}
+
+// flattenEndpointAccessV3 turns the publicAccessEnabled/privateAccessEnabled/
+// authorizedNetworks extendParam keys back into the endpoint_access block
+// shape, so changes made outside Terraform are detected as drift.
+func flattenEndpointAccessV3(extendParam map[string]string) []map[string]interface{} {
+	publicAccess, hasPublic := extendParam["publicAccessEnabled"]
+	privateAccess, hasPrivate := extendParam["privateAccessEnabled"]
+	if !hasPublic && !hasPrivate {
+		return nil
+	}
+
+	var networks []interface{}
+	if raw := extendParam["authorizedNetworks"]; raw != "" {
+		for _, n := range strings.Split(raw, ",") {
+			networks = append(networks, n)
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"public_access_enabled":  publicAccess == "true",
+			"private_access_enabled": privateAccess == "true",
+			"authorized_networks":    networks,
+		},
+	}
+}
+
+func resourceClusterMastersV3(d *schema.ResourceData) []clusters.MasterSpec {
+	masterRaw := d.Get("masters").([]interface{})
+	masters := make([]clusters.MasterSpec, len(masterRaw))
+	for i, raw := range masterRaw {
+		masterMap := raw.(map[string]interface{})
+		masters[i] = clusters.MasterSpec{MasterAZ: masterMap["availability_zone"].(string)}
+	}
+	return masters
+}
+
 func resourceCCEClusterV3Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	cceClient, err := config.cceV3Client(GetRegion(d, config))
@@ -245,6 +406,7 @@ func resourceCCEClusterV3Create(d *schema.ResourceData, meta interface{}) error
 			Authentication: clusters.AuthenticationSpec{Mode: d.Get("authentication_mode").(string),
 				AuthenticatingProxy: authenticating_proxy},
 			BillingMode: d.Get("billing_mode").(int),
+			Masters:     resourceClusterMastersV3(d),
 			ExtendParam: resourceClusterExtendParamV3(d),
 		},
 	}
@@ -306,6 +468,8 @@ func resourceCCEClusterV3Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("container_network_type", n.Spec.ContainerNetwork.Mode)
 	d.Set("container_network_cidr", n.Spec.ContainerNetwork.Cidr)
 	d.Set("authentication_mode", n.Spec.Authentication.Mode)
+	d.Set("eip", n.Status.MasterEIP)
+	d.Set("endpoint_access", flattenEndpointAccessV3(n.Spec.ExtendParam))
 	d.Set("region", GetRegion(d, config))
 
 	cert, err := clusters.GetCert(cceClient, d.Id()).Extract()
@@ -335,6 +499,13 @@ func resourceCCEClusterV3Read(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("certificate_users", userList)
 
+	kubeConfigRaw, err := buildKubeConfigRaw(cert, "")
+	if err != nil {
+		log.Printf("[WARN] Error building kube_config_raw for HuaweiCloud CCE cluster %s: %s", d.Id(), err)
+	} else {
+		d.Set("kube_config_raw", kubeConfigRaw)
+	}
+
 	return nil
 }
 
@@ -350,15 +521,73 @@ func resourceCCEClusterV3Update(d *schema.ResourceData, meta interface{}) error
 	if d.HasChange("description") {
 		updateOpts.Spec.Description = d.Get("description").(string)
 	}
+	if d.HasChange("endpoint_access") {
+		updateOpts.Spec.ExtendParam = resourceClusterEndpointAccessExtendParamV3(d)
+	}
 	_, err = clusters.Update(cceClient, d.Id(), updateOpts).Extract()
 
 	if err != nil {
 		return fmt.Errorf("Error updating HuaweiCloud CCE: %s", err)
 	}
 
+	if d.HasChange("eip") {
+		if err := resourceCCEClusterV3UpdateMasterEIP(d, cceClient); err != nil {
+			return err
+		}
+	}
+
 	return resourceCCEClusterV3Read(d, meta)
 }
 
+// resourceCCEClusterV3UpdateMasterEIP binds or unbinds the public EIP attached
+// to the cluster master without recreating the cluster.
+func resourceCCEClusterV3UpdateMasterEIP(d *schema.ResourceData, cceClient *golangsdk.ServiceClient) error {
+	o, n := d.GetChange("eip")
+	oldEIP := o.(string)
+	newEIP := n.(string)
+
+	if oldEIP != "" {
+		if err := clusters.UpdateMasterEip(cceClient, d.Id(), clusters.MasterEipOpts{Action: "unbind"}).ExtractErr(); err != nil {
+			return fmt.Errorf("Error unbinding HuaweiCloud CCE master EIP: %s", err)
+		}
+	}
+	if newEIP != "" {
+		if err := clusters.UpdateMasterEip(cceClient, d.Id(), clusters.MasterEipOpts{Action: "bind", PublicIP: newEIP}).ExtractErr(); err != nil {
+			return fmt.Errorf("Error binding HuaweiCloud CCE master EIP: %s", err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Updating"},
+		Target:     []string{"Updated"},
+		Refresh:    waitForCCEClusterMasterEIP(cceClient, d.Id(), newEIP),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud CCE master EIP to update: %s", err)
+	}
+
+	return nil
+}
+
+func waitForCCEClusterMasterEIP(cceClient *golangsdk.ServiceClient, clusterId, expectedEIP string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := clusters.Get(cceClient, clusterId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if n.Status.MasterEIP == expectedEIP {
+			return n, "Updated", nil
+		}
+		return n, "Updating", nil
+	}
+}
+
 func resourceCCEClusterV3Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	cceClient, err := config.cceV3Client(GetRegion(d, config))
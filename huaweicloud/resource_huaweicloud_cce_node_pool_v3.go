@@ -0,0 +1,410 @@
+package huaweicloud
+
+import (
+	"fmt"
+	log "github.com/sourcegraph-ce/logrus"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/cce/v3/nodepools"
+)
+
+func resourceCCENodePoolV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCCENodePoolV3Create,
+		Read:   resourceCCENodePoolV3Read,
+		Update: resourceCCENodePoolV3Update,
+		Delete: resourceCCENodePoolV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		//request and response parameters
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"os": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_pair": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"initial_node_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"min_node_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"max_node_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"scale_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"taints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"effect": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"root_volume": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"volumetype": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"data_volumes": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"volumetype": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"preinstall": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"postinstall": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCCENodePoolTaintsV3(d *schema.ResourceData) []nodepools.TaintSpec {
+	taintRaw := d.Get("taints").([]interface{})
+	taints := make([]nodepools.TaintSpec, len(taintRaw))
+	for i, raw := range taintRaw {
+		taintMap := raw.(map[string]interface{})
+		taints[i] = nodepools.TaintSpec{
+			Key:    taintMap["key"].(string),
+			Value:  taintMap["value"].(string),
+			Effect: taintMap["effect"].(string),
+		}
+	}
+	return taints
+}
+
+func resourceCCENodePoolLabelsV3(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+	for key, val := range d.Get("labels").(map[string]interface{}) {
+		m[key] = val.(string)
+	}
+	return m
+}
+
+func resourceCCENodePoolRootVolumeV3(d *schema.ResourceData) nodepools.VolumeSpec {
+	volumeRaw := d.Get("root_volume").([]interface{})[0].(map[string]interface{})
+	return nodepools.VolumeSpec{
+		Size:       volumeRaw["size"].(int),
+		VolumeType: volumeRaw["volumetype"].(string),
+	}
+}
+
+func resourceCCENodePoolDataVolumesV3(d *schema.ResourceData) []nodepools.VolumeSpec {
+	volumeRaw := d.Get("data_volumes").([]interface{})
+	volumes := make([]nodepools.VolumeSpec, len(volumeRaw))
+	for i, raw := range volumeRaw {
+		volumeMap := raw.(map[string]interface{})
+		volumes[i] = nodepools.VolumeSpec{
+			Size:       volumeMap["size"].(int),
+			VolumeType: volumeMap["volumetype"].(string),
+		}
+	}
+	return volumes
+}
+
+func resourceCCENodePoolV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Unable to create HuaweiCloud CCE client : %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	createOpts := nodepools.CreateOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Metadata: nodepools.CreateMetaData{
+			Name: d.Get("name").(string),
+		},
+		Spec: nodepools.Spec{
+			InitialNodeCount: d.Get("initial_node_count").(int),
+			Autoscaling: nodepools.AutoscalingSpec{
+				Enable:       d.Get("scale_enable").(bool),
+				MinNodeCount: d.Get("min_node_count").(int),
+				MaxNodeCount: d.Get("max_node_count").(int),
+				Priority:     d.Get("priority").(int),
+			},
+			NodeTemplate: nodepools.NodeTemplateSpec{
+				Flavor: d.Get("flavor_id").(string),
+				Az:     d.Get("availability_zone").(string),
+				Os:     d.Get("os").(string),
+				Login: nodepools.LoginSpec{
+					SshKey: d.Get("key_pair").(string),
+				},
+				RootVolume:  resourceCCENodePoolRootVolumeV3(d),
+				DataVolumes: resourceCCENodePoolDataVolumesV3(d),
+				NodeNicSpec: nodepools.NodeNicSpec{PrimaryNic: nodepools.PrimaryNic{SubnetId: d.Get("subnet_id").(string)}},
+				K8sTags:     resourceCCENodePoolLabelsV3(d),
+				Taints:      resourceCCENodePoolTaintsV3(d),
+				ExtendParam: nodepools.ExtendParam{
+					PreInstall:  d.Get("preinstall").(string),
+					PostInstall: d.Get("postinstall").(string),
+				},
+			},
+		},
+	}
+
+	create, err := nodepools.Create(cceClient, clusterId, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE node pool: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud CCE node pool (%s) to become available", create.Metadata.Id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Synchronizing", "Synchronized"},
+		Target:     []string{"Active"},
+		Refresh:    waitForCCENodePoolActive(cceClient, clusterId, create.Metadata.Id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      20 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE node pool: %s", err)
+	}
+	d.SetId(create.Metadata.Id)
+
+	return resourceCCENodePoolV3Read(d, meta)
+}
+
+func resourceCCENodePoolV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	n, err := nodepools.Get(cceClient, clusterId, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving HuaweiCloud CCE node pool: %s", err)
+	}
+
+	d.Set("name", n.Metadata.Name)
+	d.Set("initial_node_count", n.Spec.InitialNodeCount)
+	d.Set("scale_enable", n.Spec.Autoscaling.Enable)
+	d.Set("min_node_count", n.Spec.Autoscaling.MinNodeCount)
+	d.Set("max_node_count", n.Spec.Autoscaling.MaxNodeCount)
+	d.Set("priority", n.Spec.Autoscaling.Priority)
+	d.Set("flavor_id", n.Spec.NodeTemplate.Flavor)
+	d.Set("availability_zone", n.Spec.NodeTemplate.Az)
+	d.Set("os", n.Spec.NodeTemplate.Os)
+	d.Set("status", n.Status.Phase)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceCCENodePoolV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE Client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	var updateOpts nodepools.UpdateOpts
+
+	if d.HasChange("initial_node_count") {
+		updateOpts.Spec.InitialNodeCount = d.Get("initial_node_count").(int)
+	}
+	if d.HasChange("min_node_count") || d.HasChange("max_node_count") || d.HasChange("scale_enable") || d.HasChange("priority") {
+		updateOpts.Spec.Autoscaling = nodepools.AutoscalingSpec{
+			Enable:       d.Get("scale_enable").(bool),
+			MinNodeCount: d.Get("min_node_count").(int),
+			MaxNodeCount: d.Get("max_node_count").(int),
+			Priority:     d.Get("priority").(int),
+		}
+	}
+
+	_, err = nodepools.Update(cceClient, clusterId, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud CCE node pool: %s", err)
+	}
+
+	return resourceCCENodePoolV3Read(d, meta)
+}
+
+func resourceCCENodePoolV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE Client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	err = nodepools.Delete(cceClient, clusterId, d.Id()).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CCE node pool: %s", err)
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Deleting", "Active"},
+		Target:     []string{"Deleted"},
+		Refresh:    waitForCCENodePoolDelete(cceClient, clusterId, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CCE node pool: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForCCENodePoolActive(cceClient *golangsdk.ServiceClient, clusterId, poolId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := nodepools.Get(cceClient, clusterId, poolId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return n, n.Status.Phase, nil
+	}
+}
+
+func waitForCCENodePoolDelete(cceClient *golangsdk.ServiceClient, clusterId, poolId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete HuaweiCloud CCE node pool %s.\n", poolId)
+
+		r, err := nodepools.Get(cceClient, clusterId, poolId).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud CCE node pool %s", poolId)
+				return r, "Deleted", nil
+			}
+		}
+		log.Printf("[DEBUG] HuaweiCloud CCE node pool %s still available.\n", poolId)
+		return r, "Active", nil
+	}
+}
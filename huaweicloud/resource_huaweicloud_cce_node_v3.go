@@ -0,0 +1,394 @@
+package huaweicloud
+
+import (
+	"fmt"
+	log "github.com/sourcegraph-ce/logrus"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/cce/v3/nodes"
+)
+
+func resourceCCENodeV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCCENodeV3Create,
+		Read:   resourceCCENodeV3Read,
+		Update: resourceCCENodeV3Update,
+		Delete: resourceCCENodeV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		//request and response parameters
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"os": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_pair": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"taints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"effect": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"root_volume": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"volumetype": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"data_volumes": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"volumetype": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"preinstall": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"postinstall": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCCENodeTaintsV3(d *schema.ResourceData) []nodes.TaintSpec {
+	taintRaw := d.Get("taints").([]interface{})
+	taints := make([]nodes.TaintSpec, len(taintRaw))
+	for i, raw := range taintRaw {
+		taintMap := raw.(map[string]interface{})
+		taints[i] = nodes.TaintSpec{
+			Key:    taintMap["key"].(string),
+			Value:  taintMap["value"].(string),
+			Effect: taintMap["effect"].(string),
+		}
+	}
+	return taints
+}
+
+func resourceCCENodeLabelsV3(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+	for key, val := range d.Get("labels").(map[string]interface{}) {
+		m[key] = val.(string)
+	}
+	return m
+}
+
+func resourceCCERootVolumeV3(d *schema.ResourceData) nodes.VolumeSpec {
+	volumeRaw := d.Get("root_volume").([]interface{})[0].(map[string]interface{})
+	return nodes.VolumeSpec{
+		Size:       volumeRaw["size"].(int),
+		VolumeType: volumeRaw["volumetype"].(string),
+	}
+}
+
+func resourceCCEDataVolumesV3(d *schema.ResourceData) []nodes.VolumeSpec {
+	volumeRaw := d.Get("data_volumes").([]interface{})
+	volumes := make([]nodes.VolumeSpec, len(volumeRaw))
+	for i, raw := range volumeRaw {
+		volumeMap := raw.(map[string]interface{})
+		volumes[i] = nodes.VolumeSpec{
+			Size:       volumeMap["size"].(int),
+			VolumeType: volumeMap["volumetype"].(string),
+		}
+	}
+	return volumes
+}
+
+func resourceCCENodeV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Unable to create HuaweiCloud CCE client : %s", err)
+	}
+
+	createOpts := nodes.CreateOpts{
+		Kind:       "Node",
+		ApiVersion: "v3",
+		Metadata: nodes.CreateMetaData{
+			Name:   d.Get("name").(string),
+			Labels: resourceCCENodeLabelsV3(d),
+		},
+		Spec: nodes.Spec{
+			Flavor: d.Get("flavor_id").(string),
+			Az:     d.Get("availability_zone").(string),
+			Os:     d.Get("os").(string),
+			Login: nodes.LoginSpec{
+				SshKey: d.Get("key_pair").(string),
+			},
+			RootVolume:  resourceCCERootVolumeV3(d),
+			DataVolumes: resourceCCEDataVolumesV3(d),
+			PublicIP:    nodes.PublicIPSpec{Eip: nodes.EipSpec{}},
+			NodeNicSpec: nodes.NodeNicSpec{PrimaryNic: nodes.PrimaryNic{SubnetId: d.Get("subnet_id").(string)}},
+			Taints:      resourceCCENodeTaintsV3(d),
+			UserTags:    nil,
+			BillingMode: 0,
+			Count:       1,
+			ExtendParam: nodes.ExtendParam{
+				PreInstall:  d.Get("preinstall").(string),
+				PostInstall: d.Get("postinstall").(string),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		createOpts.Spec.Login.UserPassword = v.(string)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	create, err := nodes.Create(cceClient, clusterId, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud Node: %s", err)
+	}
+
+	nodeId, err := getCCENodeIDFromJob(cceClient, create.Status.JobID)
+	if err != nil {
+		return fmt.Errorf("Error fetching HuaweiCloud Node ID: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud CCE node (%s) to become available", nodeId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Installing"},
+		Target:     []string{"Active"},
+		Refresh:    waitForCCENodeActive(cceClient, clusterId, nodeId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      20 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE node: %s", err)
+	}
+	d.SetId(nodeId)
+
+	return resourceCCENodeV3Read(d, meta)
+}
+
+func resourceCCENodeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	n, err := nodes.Get(cceClient, clusterId, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving HuaweiCloud Node: %s", err)
+	}
+
+	d.Set("name", n.Metadata.Name)
+	d.Set("flavor_id", n.Spec.Flavor)
+	d.Set("availability_zone", n.Spec.Az)
+	d.Set("os", n.Spec.Os)
+	d.Set("key_pair", n.Spec.Login.SshKey)
+	d.Set("status", n.Status.Phase)
+	d.Set("private_ip", n.Status.PrivateIP)
+	d.Set("public_ip", n.Status.PublicIP)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceCCENodeV3Update(d *schema.ResourceData, meta interface{}) error {
+	return resourceCCENodeV3Read(d, meta)
+}
+
+func resourceCCENodeV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	cceClient, err := config.cceV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CCE Client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	err = nodes.Delete(cceClient, clusterId, d.Id()).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CCE Node: %s", err)
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Deleting", "Active"},
+		Target:     []string{"Deleted"},
+		Refresh:    waitForCCENodeDelete(cceClient, clusterId, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CCE node: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getCCENodeIDFromJob(cceClient *golangsdk.ServiceClient, jobID string) (string, error) {
+	job, err := nodes.GetJobDetails(cceClient, jobID).ExtractJob()
+	if err != nil {
+		return "", err
+	}
+	for _, ref := range job.Spec.SubJobs {
+		for _, res := range ref.Spec.Resources {
+			if res.ResourceType == "node" {
+				return res.ResourceID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("Could not find node resource in job %s", jobID)
+}
+
+func waitForCCENodeActive(cceClient *golangsdk.ServiceClient, clusterId, nodeId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := nodes.Get(cceClient, clusterId, nodeId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return n, n.Status.Phase, nil
+	}
+}
+
+func waitForCCENodeDelete(cceClient *golangsdk.ServiceClient, clusterId, nodeId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete HuaweiCloud CCE node %s.\n", nodeId)
+
+		r, err := nodes.Get(cceClient, clusterId, nodeId).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud CCE node %s", nodeId)
+				return r, "Deleted", nil
+			}
+		}
+		if r.Status.Phase == "Deleting" {
+			return r, "Deleting", nil
+		}
+		log.Printf("[DEBUG] HuaweiCloud CCE node %s still available.\n", nodeId)
+		return r, "Active", nil
+	}
+}
@@ -0,0 +1,119 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v3/peerings"
+)
+
+// resourceVpcPeeringConnectionAccepterV3 lets the peer side of a cross-tenant
+// VPC peering connection accept (or reject) it from its own root module,
+// without needing credentials for the requester's tenant.
+func resourceVpcPeeringConnectionAccepterV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcPeeringConnectionAccepterV3Create,
+		Read:   resourceVpcPeeringConnectionAccepterV3Read,
+		Update: resourceVpcPeeringConnectionAccepterV3Create,
+		Delete: resourceVpcPeeringConnectionAccepterV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"accept": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpcPeeringConnectionAccepterV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc peering client: %s", err)
+	}
+
+	id := d.Get("vpc_peering_connection_id").(string)
+
+	if d.Get("accept").(bool) {
+		if err := peerings.Accept(peeringClient, id).ExtractErr(); err != nil {
+			return fmt.Errorf("Error accepting Huaweicloud VPC peering connection %s: %s", id, err)
+		}
+	} else {
+		if err := peerings.Reject(peeringClient, id).ExtractErr(); err != nil {
+			return fmt.Errorf("Error rejecting Huaweicloud VPC peering connection %s: %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_ACCEPTANCE"},
+		Target:     []string{"ACTIVE", "REJECTED"},
+		Refresh:    waitForVpcPeeringConnectionActive(peeringClient, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC peering connection (%s) to settle: %s", id, err)
+	}
+
+	return resourceVpcPeeringConnectionAccepterV3Read(d, meta)
+}
+
+func resourceVpcPeeringConnectionAccepterV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc peering client: %s", err)
+	}
+
+	n, err := peerings.Get(peeringClient, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Huaweicloud VPC peering connection: %s", err)
+	}
+
+	d.Set("status", n.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcPeeringConnectionAccepterV3Delete(d *schema.ResourceData, meta interface{}) error {
+	// Accepting/rejecting a peering connection does not create any object
+	// of its own; deleting this resource simply drops it from state and
+	// leaves the underlying peering connection, owned by
+	// huaweicloud_vpc_peering_connection_v3, untouched.
+	d.SetId("")
+	return nil
+}
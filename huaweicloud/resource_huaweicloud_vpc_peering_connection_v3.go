@@ -0,0 +1,261 @@
+package huaweicloud
+
+import (
+	"fmt"
+	log "github.com/sourcegraph-ce/logrus"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v3/peerings"
+)
+
+func resourceVpcPeeringConnectionV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcPeeringConnectionV3Create,
+		Read:   resourceVpcPeeringConnectionV3Read,
+		Delete: resourceVpcPeeringConnectionV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"peer_vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"peer_tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"peer_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"auto_accept": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"accepter_access_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"accepter_secret_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpcPeeringConnectionV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc peering client: %s", err)
+	}
+
+	createOpts := peerings.CreateOpts{
+		Name:  d.Get("name").(string),
+		VpcId: d.Get("vpc_id").(string),
+		PeerVpc: peerings.PeerVpc{
+			VpcId:    d.Get("peer_vpc_id").(string),
+			TenantId: d.Get("peer_tenant_id").(string),
+		},
+	}
+
+	n, err := peerings.Create(peeringClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud VPC peering connection: %s", err)
+	}
+	d.SetId(n.ID)
+
+	log.Printf("[INFO] Vpc peering connection ID: %s", n.ID)
+
+	isCrossTenant := d.Get("peer_tenant_id").(string) != "" && d.Get("peer_tenant_id").(string) != config.TenantID
+
+	if isCrossTenant && d.Get("auto_accept").(bool) {
+		accepterClient, err := accepterNetworkingV3Client(d, config)
+		if err != nil {
+			return fmt.Errorf("Error creating accepter vpc peering client: %s", err)
+		}
+		if err := peerings.Accept(accepterClient, n.ID).ExtractErr(); err != nil {
+			return fmt.Errorf("Error auto-accepting Huaweicloud VPC peering connection: %s", err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_ACCEPTANCE"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForVpcPeeringConnectionActive(peeringClient, n.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if !isCrossTenant || d.Get("auto_accept").(bool) {
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for VPC peering connection (%s) to become ACTIVE: %s", n.ID, err)
+		}
+	}
+
+	return resourceVpcPeeringConnectionV3Read(d, meta)
+}
+
+func resourceVpcPeeringConnectionV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc peering client: %s", err)
+	}
+
+	n, err := peerings.Get(peeringClient, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Huaweicloud VPC peering connection: %s", err)
+	}
+
+	d.Set("name", n.Name)
+	d.Set("vpc_id", n.VpcId)
+	d.Set("peer_vpc_id", n.PeerVpc.VpcId)
+	d.Set("peer_tenant_id", n.PeerVpc.TenantId)
+	d.Set("peer_region", n.PeerVpc.Region)
+	d.Set("status", n.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcPeeringConnectionV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	peeringClient, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc peering client: %s", err)
+	}
+
+	err = peerings.Delete(peeringClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Huaweicloud VPC peering connection: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "PENDING_ACCEPTANCE", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForVpcPeeringConnectionDelete(peeringClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error deleting Huaweicloud VPC peering connection: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// accepterNetworkingV3Client builds a networking v3 client authenticated with
+// the peer tenant's own AK/SK, scoped to the peer VPC's region, so a
+// cross-account connection can be accepted without requiring the peer to run
+// their own apply.
+func accepterNetworkingV3Client(d *schema.ResourceData, config *Config) (*golangsdk.ServiceClient, error) {
+	accessKey := d.Get("accepter_access_key").(string)
+	secretKey := d.Get("accepter_secret_key").(string)
+	if accessKey == "" || secretKey == "" {
+		return nil, fmt.Errorf("accepter_access_key and accepter_secret_key are required to auto-accept a cross-tenant peering connection")
+	}
+
+	region := d.Get("peer_region").(string)
+	if region == "" {
+		region = GetRegion(d, config)
+	}
+
+	ao := golangsdk.AuthOptions{
+		IdentityEndpoint: config.IdentityEndpoint,
+		AccessKey:        accessKey,
+		SecretKey:        secretKey,
+		Domain:           config.DomainID,
+	}
+
+	provider, err := openstack.AuthenticatedClient(ao)
+	if err != nil {
+		return nil, fmt.Errorf("Error authenticating accepter credentials: %s", err)
+	}
+
+	return openstack.NewNetworkV3(provider, golangsdk.EndpointOpts{Region: region})
+}
+
+func waitForVpcPeeringConnectionActive(peeringClient *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := peerings.Get(peeringClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return n, n.Status, nil
+	}
+}
+
+func waitForVpcPeeringConnectionDelete(peeringClient *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete Huaweicloud VPC peering connection %s.\n", id)
+
+		r, err := peerings.Get(peeringClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted Huaweicloud VPC peering connection %s", id)
+				return r, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return r, "DELETING", nil
+	}
+}
@@ -0,0 +1,126 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v3/routes"
+)
+
+func resourceVpcRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcRouteCreate,
+		Read:   resourceVpcRouteRead,
+		Delete: resourceVpcRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDR,
+			},
+			"nexthop_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nexthop": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVpcRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+
+	createOpts := routes.CreateOpts{
+		RouteTableId: d.Get("route_table_id").(string),
+		Destination:  d.Get("destination").(string),
+		Type:         d.Get("nexthop_type").(string),
+		NextHop:      d.Get("nexthop").(string),
+		Description:  d.Get("description").(string),
+	}
+
+	n, err := routes.Create(vpcV3Client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud VPC route: %s", err)
+	}
+	d.SetId(n.ID)
+
+	return resourceVpcRouteRead(d, meta)
+}
+
+func resourceVpcRouteRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+
+	n, err := routes.Get(vpcV3Client, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Huaweicloud VPC route: %s", err)
+	}
+
+	d.Set("route_table_id", n.RouteTableId)
+	d.Set("destination", n.Destination)
+	d.Set("nexthop_type", n.Type)
+	d.Set("nexthop", n.NextHop)
+	d.Set("description", n.Description)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+
+	err = routes.Delete(vpcV3Client, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Huaweicloud VPC route: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
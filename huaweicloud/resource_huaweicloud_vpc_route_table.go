@@ -0,0 +1,173 @@
+package huaweicloud
+
+import (
+	"fmt"
+	log "github.com/sourcegraph-ce/logrus"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v3/routetables"
+)
+
+func resourceVpcRouteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcRouteTableCreate,
+		Read:   resourceVpcRouteTableRead,
+		Update: resourceVpcRouteTableUpdate,
+		Delete: resourceVpcRouteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateName,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpcRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+
+	createOpts := routetables.CreateOpts{
+		Name:        d.Get("name").(string),
+		VpcId:       d.Get("vpc_id").(string),
+		Description: d.Get("description").(string),
+	}
+
+	n, err := routetables.Create(vpcV3Client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud VPC route table: %s", err)
+	}
+	d.SetId(n.ID)
+
+	log.Printf("[INFO] Route table ID: %s", n.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForVpcRouteTableActive(vpcV3Client, n.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, stateErr := stateConf.WaitForState()
+	if stateErr != nil {
+		return fmt.Errorf(
+			"Error waiting for route table (%s) to become ACTIVE: %s",
+			n.ID, stateErr)
+	}
+
+	return resourceVpcRouteTableRead(d, meta)
+}
+
+func resourceVpcRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+
+	n, err := routetables.Get(vpcV3Client, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Huaweicloud VPC route table: %s", err)
+	}
+
+	d.Set("name", n.Name)
+	d.Set("vpc_id", n.VpcId)
+	d.Set("description", n.Description)
+	d.Set("status", n.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVpcRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+
+	var updateOpts routetables.UpdateOpts
+
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		updateOpts.Description = d.Get("description").(string)
+	}
+
+	_, err = routetables.Update(vpcV3Client, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating Huaweicloud VPC route table: %s", err)
+	}
+
+	return resourceVpcRouteTableRead(d, meta)
+}
+
+func resourceVpcRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+
+	err = routetables.Delete(vpcV3Client, d.Id()).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error deleting Huaweicloud VPC route table: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForVpcRouteTableActive(vpcV3Client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := routetables.Get(vpcV3Client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return n, n.Status, nil
+	}
+}
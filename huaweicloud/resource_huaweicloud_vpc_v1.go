@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/huaweicloud/golangsdk/openstack/common/tags"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v1/vpcs"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v3/cidrs"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v3/routetables"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/huaweicloud/golangsdk"
@@ -71,6 +73,16 @@ func resourceVirtualPrivateCloudV1() *schema.Resource {
 					},
 				},
 			},
+			"secondary_cidrs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCIDR},
+			},
+			"route_table_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -114,6 +126,21 @@ func resourceVirtualPrivateCloudV1Create(d *schema.ResourceData, meta interface{
 			n.ID, stateErr)
 	}
 
+	//associate secondary CIDR blocks
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+	for _, v := range d.Get("secondary_cidrs").([]interface{}) {
+		createCidrOpts := cidrs.CreateOpts{
+			Cidr:  v.(string),
+			VpcId: n.ID,
+		}
+		if _, err := cidrs.Create(vpcV3Client, createCidrOpts).Extract(); err != nil {
+			return fmt.Errorf("Error associating secondary CIDR %s to VPC %s: %s", v.(string), n.ID, err)
+		}
+	}
+
 	//set tags
 	tagRaw := d.Get("tags").(map[string]interface{})
 	if len(tagRaw) > 0 {
@@ -164,6 +191,36 @@ func resourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("routes", routes)
 
+	// save secondary CIDR blocks
+	vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+	}
+	cidrList, err := cidrs.List(vpcV3Client, cidrs.ListOpts{VpcId: d.Id()}).Extract()
+	if err != nil {
+		log.Printf("[WARN] Error fetching secondary CIDRs of VPC %s: %s", d.Id(), err)
+	} else {
+		secondaryCidrs := make([]string, 0, len(cidrList))
+		for _, c := range cidrList {
+			if c.Cidr != n.CIDR {
+				secondaryCidrs = append(secondaryCidrs, c.Cidr)
+			}
+		}
+		d.Set("secondary_cidrs", secondaryCidrs)
+	}
+
+	// save route tables associated with this VPC
+	rtList, err := routetables.List(vpcV3Client, routetables.ListOpts{VpcId: d.Id()}).Extract()
+	if err != nil {
+		log.Printf("[WARN] Error fetching route tables of VPC %s: %s", d.Id(), err)
+	} else {
+		routeTableIds := make([]string, len(rtList))
+		for i, rt := range rtList {
+			routeTableIds[i] = rt.ID
+		}
+		d.Set("route_table_ids", routeTableIds)
+	}
+
 	// save VirtualPrivateCloudV2 tags
 	vpcV2Client, err := config.networkingV2Client(GetRegion(d, config))
 	if err != nil {
@@ -207,6 +264,37 @@ func resourceVirtualPrivateCloudV1Update(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error updating Huaweicloud Vpc: %s", err)
 	}
 
+	//update secondary CIDR blocks
+	if d.HasChange("secondary_cidrs") {
+		vpcV3Client, err := config.networkingV3Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating Huaweicloud vpc client: %s", err)
+		}
+
+		o, n := d.GetChange("secondary_cidrs")
+		oldCidrs := o.([]interface{})
+		newCidrs := n.([]interface{})
+
+		for _, v := range oldCidrs {
+			if !sliceContainsStr(newCidrs, v.(string)) {
+				if err := cidrs.Delete(vpcV3Client, d.Id(), v.(string)).ExtractErr(); err != nil {
+					return fmt.Errorf("Error disassociating secondary CIDR %s from VPC %s: %s", v.(string), d.Id(), err)
+				}
+			}
+		}
+		for _, v := range newCidrs {
+			if !sliceContainsStr(oldCidrs, v.(string)) {
+				createCidrOpts := cidrs.CreateOpts{
+					Cidr:  v.(string),
+					VpcId: d.Id(),
+				}
+				if _, err := cidrs.Create(vpcV3Client, createCidrOpts).Extract(); err != nil {
+					return fmt.Errorf("Error associating secondary CIDR %s to VPC %s: %s", v.(string), d.Id(), err)
+				}
+			}
+		}
+	}
+
 	//update tags
 	if d.HasChange("tags") {
 		vpcV2Client, err := config.networkingV2Client(GetRegion(d, config))
@@ -249,6 +337,15 @@ func resourceVirtualPrivateCloudV1Delete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func sliceContainsStr(list []interface{}, target string) bool {
+	for _, v := range list {
+		if v.(string) == target {
+			return true
+		}
+	}
+	return false
+}
+
 func waitForVpcActive(vpcClient *golangsdk.ServiceClient, vpcId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := vpcs.Get(vpcClient, vpcId).Extract()